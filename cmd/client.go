@@ -0,0 +1,16 @@
+package cmd
+
+import "github.com/ExtraTypical/banko-cli/internal/box"
+
+// newClient builds a Box client from the --config/--device flags shared
+// by every subcommand that talks to Box.
+func newClient() (*box.Client, error) {
+	config, err := box.LoadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if useDevice {
+		config.AuthMode = box.AuthModeDevice
+	}
+	return box.NewClient(config)
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Authenticate with Box and cache the access token",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := newClient(); err != nil {
+			return err
+		}
+		fmt.Println("Logged in to Box.")
+		return nil
+	},
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var lsCmd = &cobra.Command{
+	Use:   "ls [location]",
+	Short: "List the images available from a provider",
+	Long: `List the images available from a provider.
+
+location may be a bare Box folder ID (falling back to --folder or
+BANKO_FOLDER_ID), or a URL selecting a provider by scheme:
+box://folderID, s3://bucket/prefix, file:///path, webdav://host/path.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		location := folderID
+		if len(args) > 0 {
+			location = args[0]
+		}
+		if location == "" {
+			return errors.New("a location is required (pass it as an argument, --folder, or BANKO_FOLDER_ID)")
+		}
+
+		p, subPath, err := resolveProvider(location)
+		if err != nil {
+			return err
+		}
+
+		images, err := p.ListImages(subPath)
+		if err != nil {
+			return err
+		}
+
+		for _, img := range images {
+			fmt.Printf("%s\t%s\n", img.ID, img.Name)
+		}
+		return nil
+	},
+}
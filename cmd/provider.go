@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ExtraTypical/banko-cli/internal/provider"
+)
+
+// resolveProvider selects an image provider from location's URL scheme
+// (box://folderID, s3://bucket/prefix, file:///path, webdav://host/path)
+// and returns it along with the folder/subpath to list within it. A
+// location with no scheme is treated as a bare Box folder ID, for
+// backwards compatibility with --folder/BANKO_FOLDER_ID.
+func resolveProvider(location string) (provider.Provider, string, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse provider location %q: %v", location, err)
+	}
+
+	switch u.Scheme {
+	case "", "box":
+		folderID := u.Host
+		if folderID == "" {
+			folderID = strings.TrimPrefix(u.Path, "/")
+		}
+		if folderID == "" {
+			folderID = location
+		}
+		client, err := newClient()
+		if err != nil {
+			return nil, "", err
+		}
+		return provider.NewBoxProvider(client), folderID, nil
+
+	case "s3":
+		bucket := u.Host
+		prefix := strings.TrimPrefix(u.Path, "/")
+		p, err := provider.NewS3Provider(context.Background(), bucket, prefix, os.Getenv("BANKO_S3_ENDPOINT"))
+		if err != nil {
+			return nil, "", err
+		}
+		return p, "", nil
+
+	case "file":
+		return provider.NewLocalProvider(u.Path), "", nil
+
+	case "webdav":
+		scheme := "https"
+		if os.Getenv("BANKO_WEBDAV_INSECURE") != "" {
+			scheme = "http"
+		}
+		base := fmt.Sprintf("%s://%s", scheme, u.Host)
+		return provider.NewWebDAVProvider(base, u.Path, os.Getenv("BANKO_WEBDAV_USER"), os.Getenv("BANKO_WEBDAV_PASS")), "", nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported provider scheme %q", u.Scheme)
+	}
+}
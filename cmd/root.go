@@ -0,0 +1,52 @@
+// Package cmd implements the banko CLI's subcommands.
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	configPath string
+	folderID   string
+	useDevice  bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "banko",
+	Short: "Browse and render images from a Box folder in your terminal",
+}
+
+// Execute runs the banko CLI, returning any error from the selected
+// subcommand.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", envOrDefault("BANKO_CONFIG", defaultConfigPath()), "path to the Box JWT app config file")
+	rootCmd.PersistentFlags().StringVar(&folderID, "folder", envOrDefault("BANKO_FOLDER_ID", ""), "Box folder ID to browse")
+	rootCmd.PersistentFlags().BoolVar(&useDevice, "device", false, "authenticate via the OAuth device authorization flow instead of JWT")
+
+	rootCmd.AddCommand(loginCmd, lsCmd, showCmd, configCmd)
+}
+
+// defaultConfigPath returns the XDG-appropriate default location for the
+// Box JWT app config file: $XDG_CONFIG_HOME/banko/config.json (or its
+// platform equivalent).
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "config.json"
+	}
+	return filepath.Join(dir, "banko", "config.json")
+}
+
+func envOrDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
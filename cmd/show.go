@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/ExtraTypical/banko-cli/internal/provider"
+	"github.com/ExtraTypical/banko-cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	showRandom bool
+	showWidth  int
+	showMode   string
+	showDither bool
+	showGIF    bool
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show [fileID]",
+	Short: "Render an image from a provider as ASCII art",
+	Long: `Render an image from a provider as ASCII art.
+
+--folder selects the provider and location to browse, as a bare Box
+folder ID or a URL (box://folderID, s3://bucket/prefix, file:///path,
+webdav://host/path). Pass a specific fileID, or --random to pick one
+from that location.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !showRandom {
+			return errors.New("either pass a fileID or use --random")
+		}
+		if folderID == "" {
+			return errors.New("--folder or BANKO_FOLDER_ID is required to select a provider")
+		}
+
+		p, subPath, err := resolveProvider(folderID)
+		if err != nil {
+			return err
+		}
+
+		fileID, etag := "", ""
+		if len(args) > 0 {
+			fileID = args[0]
+		}
+		if showRandom || fileID == "" {
+			images, err := p.ListImages(subPath)
+			if err != nil {
+				return err
+			}
+			if len(images) == 0 {
+				return fmt.Errorf("no images found at %s", folderID)
+			}
+			image := randomImage(images)
+			fileID, etag = image.ID, image.ETag
+		}
+
+		imgData, err := p.DownloadImage(fileID, etag)
+		if err != nil {
+			return err
+		}
+
+		width := showWidth
+		if width <= 0 {
+			width = render.DetectWidth()
+		}
+		opts := render.Options{Mode: render.Mode(showMode), Width: width, Dither: showDither}
+
+		if showGIF {
+			return render.PlayGIF(imgData, opts, os.Stdout)
+		}
+
+		ascii, err := render.Render(imgData, opts)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(ascii)
+		return nil
+	},
+}
+
+func init() {
+	showCmd.Flags().BoolVar(&showRandom, "random", false, "pick a random image from --folder instead of a specific fileID")
+	showCmd.Flags().IntVar(&showWidth, "width", 0, "output width in columns (default: detect terminal width)")
+	showCmd.Flags().StringVar(&showMode, "mode", string(render.ModeASCII), "render mode: ascii, braille, or halfblock")
+	showCmd.Flags().BoolVar(&showDither, "dither", false, "apply Floyd-Steinberg dithering (ascii and braille modes)")
+	showCmd.Flags().BoolVar(&showGIF, "gif", false, "animate a multi-frame GIF in place instead of rendering one frame")
+}
+
+func randomImage(images []provider.Image) provider.Image {
+	rand.Seed(time.Now().UnixNano())
+	return images[rand.Intn(len(images))]
+}
@@ -0,0 +1,63 @@
+package box
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// ConfigFile mirrors the JSON config Box's developer console generates
+// for a JWT app (Platform App > Configuration > "App Settings").
+type ConfigFile struct {
+	BoxAppSettings BoxAppSettings `json:"boxAppSettings"`
+	EnterpriseID   string         `json:"enterpriseID"`
+}
+
+type BoxAppSettings struct {
+	ClientID     string  `json:"clientID"`
+	ClientSecret string  `json:"clientSecret"`
+	AppAuth      AppAuth `json:"appAuth"`
+}
+
+type AppAuth struct {
+	KeyID      string        `json:"keyID"`
+	PrivateKey CleanedString `json:"privateKey"`
+	Passphrase string        `json:"passphrase"`
+}
+
+// CleanedString unmarshals a JSON string with embedded newlines escaped
+// (as Box's PEM-in-JSON private keys are) and strips them back out.
+type CleanedString string
+
+func (cs *CleanedString) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*cs = CleanedString(strings.ReplaceAll(s, "\n", ""))
+	return nil
+}
+
+// LoadConfig reads a Box JWT app config file and returns the Config
+// needed to construct a Client in AuthModeJWT.
+func LoadConfig(path string) (Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	var file ConfigFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file: %v", err)
+	}
+
+	return Config{
+		ClientID:           file.BoxAppSettings.ClientID,
+		ClientSecret:       file.BoxAppSettings.ClientSecret,
+		EnterpriseID:       file.EnterpriseID,
+		PrivateKey:         []byte(file.BoxAppSettings.AppAuth.PrivateKey),
+		PrivateKeyPassword: file.BoxAppSettings.AppAuth.Passphrase,
+		PublicKeyID:        file.BoxAppSettings.AppAuth.KeyID,
+	}, nil
+}
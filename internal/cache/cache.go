@@ -0,0 +1,18 @@
+// Package cache provides TTL-based caching for data that is expensive or
+// slow to re-fetch, such as Box folder listings and downloaded images.
+package cache
+
+import "time"
+
+// Cache stores opaque byte values under string keys with optional
+// expiration. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key. The second return value is
+	// false if the key is absent or has expired.
+	Get(key string) ([]byte, bool, error)
+	// Set stores value under key. A zero ttl means the entry never expires.
+	Set(key string, value []byte, ttl time.Duration) error
+	// Delete removes key, if present. It is not an error to delete a
+	// missing key.
+	Delete(key string) error
+}
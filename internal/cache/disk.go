@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DiskCache is a Cache backed by files on disk, one per key. Keys are
+// hashed to filenames so callers can use content-addressed keys (e.g.
+// "<fileID>:<etag>") without worrying about path-unsafe characters.
+type DiskCache struct {
+	dir string
+}
+
+type diskEntry struct {
+	Value  []byte    `json:"value"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// NewDiskCache returns a DiskCache rooted at dir, creating it if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %v", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+func (c *DiskCache) Get(key string) ([]byte, bool, error) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cache entry: %v", err)
+	}
+
+	var entry diskEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, fmt.Errorf("failed to decode cache entry: %v", err)
+	}
+	if !entry.Expiry.IsZero() && time.Now().After(entry.Expiry) {
+		c.Delete(key)
+		return nil, false, nil
+	}
+	return entry.Value, true, nil
+}
+
+func (c *DiskCache) Set(key string, value []byte, ttl time.Duration) error {
+	var expiry time.Time
+	if ttl > 0 {
+		expiry = time.Now().Add(ttl)
+	}
+
+	data, err := json.Marshal(diskEntry{Value: value, Expiry: expiry})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %v", err)
+	}
+	return ioutil.WriteFile(c.path(key), data, 0600)
+}
+
+func (c *DiskCache) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove cache entry: %v", err)
+	}
+	return nil
+}
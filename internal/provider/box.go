@@ -0,0 +1,33 @@
+package provider
+
+import "github.com/ExtraTypical/banko-cli/internal/box"
+
+// BoxProvider adapts a box.Client to the Provider interface.
+type BoxProvider struct {
+	client *box.Client
+}
+
+// NewBoxProvider wraps an already-authenticated box.Client.
+func NewBoxProvider(client *box.Client) *BoxProvider {
+	return &BoxProvider{client: client}
+}
+
+func (p *BoxProvider) ListImages(folderID string) ([]Image, error) {
+	entries, err := p.client.GetImagesFromFolder(folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]Image, 0, len(entries))
+	for _, entry := range entries {
+		id, _ := entry["id"].(string)
+		name, _ := entry["name"].(string)
+		etag, _ := entry["etag"].(string)
+		images = append(images, Image{ID: id, Name: name, ETag: etag})
+	}
+	return images, nil
+}
+
+func (p *BoxProvider) DownloadImage(id, etag string) ([]byte, error) {
+	return p.client.DownloadImage(id, etag)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalProvider serves images from a directory on the local filesystem.
+// Image IDs are paths relative to root.
+type LocalProvider struct {
+	root string
+}
+
+// NewLocalProvider returns a LocalProvider rooted at dir.
+func NewLocalProvider(dir string) *LocalProvider {
+	return &LocalProvider{root: dir}
+}
+
+func (p *LocalProvider) ListImages(folderID string) ([]Image, error) {
+	dir := filepath.Join(p.root, folderID)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory: %v", err)
+	}
+
+	var images []Image
+	for _, entry := range entries {
+		if entry.IsDir() || !isImageExtension(entry.Name()) {
+			continue
+		}
+		id := filepath.Join(folderID, entry.Name())
+		images = append(images, Image{ID: id, Name: entry.Name()})
+	}
+	return images, nil
+}
+
+func (p *LocalProvider) DownloadImage(id, etag string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(p.root, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	return data, nil
+}
+
+func isImageExtension(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".jpg", ".jpeg", ".png", ".gif":
+		return true
+	default:
+		return false
+	}
+}
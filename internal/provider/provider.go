@@ -0,0 +1,27 @@
+// Package provider decouples the ASCII-rendering pipeline from Box by
+// exposing a common interface over several image sources.
+package provider
+
+// Image describes a single image entry returned by a Provider's
+// ListImages. ETag, when non-empty, identifies the image's current
+// version for cache invalidation.
+type Image struct {
+	ID   string
+	Name string
+	ETag string
+}
+
+// Provider lists and downloads images from a single backend: Box, an
+// S3-compatible object store, a local directory, or a WebDAV share.
+type Provider interface {
+	// ListImages returns the images found under folderID, a backend-
+	// specific location (a Box folder ID, an S3 prefix, a local
+	// subdirectory, ...). An empty folderID means the provider's root.
+	ListImages(folderID string) ([]Image, error)
+	// DownloadImage returns the raw content of the image with the given
+	// ID. etag should be the ETag from the Image returned by ListImages,
+	// if any, so providers that cache downloads (e.g. Box) can serve
+	// from cache instead of re-fetching; providers without a content
+	// cache ignore it.
+	DownloadImage(id, etag string) ([]byte, error)
+}
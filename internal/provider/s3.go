@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3Provider lists and downloads objects from an S3-compatible object
+// store. Setting Endpoint lets it target MinIO, FrostFS, or any other
+// S3-compatible backend instead of AWS.
+type S3Provider struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Provider returns an S3Provider for bucket, rooted at prefix.
+// endpoint overrides the default AWS endpoint (e.g. for MinIO or
+// FrostFS); leave it empty to use AWS S3.
+func NewS3Provider(ctx context.Context, bucket, prefix, endpoint string) (*S3Provider, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %v", err)
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	return &S3Provider{client: client, bucket: bucket, prefix: strings.Trim(prefix, "/")}, nil
+}
+
+func (p *S3Provider) ListImages(folderID string) ([]Image, error) {
+	prefix := p.prefix
+	if folderID != "" {
+		prefix = path.Join(prefix, folderID)
+	}
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var images []Image
+	var continuationToken *string
+	for {
+		out, err := p.client.ListObjectsV2(context.Background(), &s3.ListObjectsV2Input{
+			Bucket:            aws.String(p.bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %v", err)
+		}
+
+		for _, obj := range out.Contents {
+			key := aws.ToString(obj.Key)
+			if !isImageExtension(key) {
+				continue
+			}
+			images = append(images, Image{ID: key, Name: path.Base(key), ETag: aws.ToString(obj.ETag)})
+		}
+
+		if !aws.ToBool(out.IsTruncated) {
+			break
+		}
+		continuationToken = out.NextContinuationToken
+	}
+	return images, nil
+}
+
+func (p *S3Provider) DownloadImage(id, etag string) ([]byte, error) {
+	out, err := p.client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(p.bucket),
+		Key:    aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %v", err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object body: %v", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/studio-b12/gowebdav"
+)
+
+// WebDAVProvider lists and downloads images from a WebDAV share.
+type WebDAVProvider struct {
+	client *gowebdav.Client
+	root   string
+}
+
+// NewWebDAVProvider returns a WebDAVProvider for the share at baseURL,
+// rooted at root within it. user/pass are optional Basic Auth
+// credentials.
+func NewWebDAVProvider(baseURL, root, user, pass string) *WebDAVProvider {
+	return &WebDAVProvider{client: gowebdav.NewClient(baseURL, user, pass), root: root}
+}
+
+func (p *WebDAVProvider) ListImages(folderID string) ([]Image, error) {
+	dir := path.Join(p.root, folderID)
+
+	entries, err := p.client.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list WebDAV directory: %v", err)
+	}
+
+	var images []Image
+	for _, entry := range entries {
+		if entry.IsDir() || !isImageExtension(entry.Name()) {
+			continue
+		}
+		id := path.Join(dir, entry.Name())
+		images = append(images, Image{ID: id, Name: entry.Name()})
+	}
+	return images, nil
+}
+
+func (p *WebDAVProvider) DownloadImage(id, etag string) ([]byte, error) {
+	data, err := p.client.Read(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WebDAV file: %v", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,40 @@
+package render
+
+import (
+	"image"
+	"strings"
+
+	"github.com/aybabtme/rgbterm"
+)
+
+var asciiRamp = []byte(" .:-=+*#%@")
+
+func renderASCII(img image.Image, dither bool) string {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	var levels [][]int
+	if dither {
+		levels = ditherLevels(luminance(img), len(asciiRamp))
+	}
+
+	var result strings.Builder
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+
+			var level int
+			if dither {
+				level = levels[y][x]
+			} else {
+				avg := (r + g + b) / 3
+				level = int(avg * uint32(len(asciiRamp)-1) / 65535)
+			}
+
+			result.WriteString(rgbterm.FgString(string(asciiRamp[level]), uint8(r>>8), uint8(g>>8), uint8(b>>8)))
+		}
+		result.WriteString("\n")
+	}
+
+	return result.String()
+}
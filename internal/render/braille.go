@@ -0,0 +1,75 @@
+package render
+
+import (
+	"image"
+	"strings"
+
+	"github.com/aybabtme/rgbterm"
+)
+
+// brailleDotBit maps a pixel's position within a 2x4 cell (row, col) to
+// its bit in the U+2800 braille block, per the Unicode dot numbering.
+var brailleDotBit = [4][2]uint8{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+func renderBraille(img image.Image, dither bool) string {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	var on [][]int
+	if dither {
+		on = ditherLevels(luminance(img), 2)
+	}
+
+	cellsX := (w + 1) / 2
+	cellsY := (h + 3) / 4
+
+	var result strings.Builder
+	for cy := 0; cy < cellsY; cy++ {
+		for cx := 0; cx < cellsX; cx++ {
+			var bits uint8
+			var rSum, gSum, bSum, count uint32
+
+			for dy := 0; dy < 4; dy++ {
+				for dx := 0; dx < 2; dx++ {
+					px, py := cx*2+dx, cy*4+dy
+					if px >= w || py >= h {
+						continue
+					}
+
+					r, g, b, _ := img.At(bounds.Min.X+px, bounds.Min.Y+py).RGBA()
+
+					var isOn bool
+					if dither {
+						isOn = on[py][px] == 1
+					} else {
+						isOn = (r+g+b)/3 > 1<<15
+					}
+					if !isOn {
+						continue
+					}
+
+					bits |= brailleDotBit[dy][dx]
+					rSum += r >> 8
+					gSum += g >> 8
+					bSum += b >> 8
+					count++
+				}
+			}
+
+			glyph := string(rune(0x2800 + int(bits)))
+			if count == 0 {
+				result.WriteString(glyph)
+			} else {
+				result.WriteString(rgbterm.FgString(glyph, uint8(rSum/count), uint8(gSum/count), uint8(bSum/count)))
+			}
+		}
+		result.WriteString("\n")
+	}
+
+	return result.String()
+}
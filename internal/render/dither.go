@@ -0,0 +1,70 @@
+package render
+
+import "image"
+
+// luminance returns img's per-pixel brightness, normalized to [0, 1].
+func luminance(img image.Image) [][]float64 {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	gray := make([][]float64, h)
+	for y := 0; y < h; y++ {
+		gray[y] = make([]float64, w)
+		for x := 0; x < w; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			gray[y][x] = (float64(r) + float64(g) + float64(b)) / 3 / 65535
+		}
+	}
+	return gray
+}
+
+// ditherLevels quantizes gray into `levels` buckets [0, levels-1] using
+// Floyd-Steinberg error diffusion, so gradients that don't map cleanly
+// onto a small palette (the 10-glyph ASCII ramp, or braille's 2 levels)
+// don't band as harshly as naive per-pixel rounding would.
+func ditherLevels(gray [][]float64, levels int) [][]int {
+	h := len(gray)
+	if h == 0 {
+		return nil
+	}
+	w := len(gray[0])
+
+	// Work on a copy so we can freely diffuse error into it.
+	work := make([][]float64, h)
+	for y := range gray {
+		work[y] = append([]float64(nil), gray[y]...)
+	}
+
+	out := make([][]int, h)
+	for y := 0; y < h; y++ {
+		out[y] = make([]int, w)
+		for x := 0; x < w; x++ {
+			old := work[y][x]
+			level := int(old*float64(levels-1) + 0.5)
+			if level < 0 {
+				level = 0
+			}
+			if level > levels-1 {
+				level = levels - 1
+			}
+			out[y][x] = level
+
+			quantized := float64(level) / float64(levels-1)
+			err := old - quantized
+
+			if x+1 < w {
+				work[y][x+1] += err * 7 / 16
+			}
+			if y+1 < h {
+				if x-1 >= 0 {
+					work[y+1][x-1] += err * 3 / 16
+				}
+				work[y+1][x] += err * 5 / 16
+				if x+1 < w {
+					work[y+1][x+1] += err * 1 / 16
+				}
+			}
+		}
+	}
+	return out
+}
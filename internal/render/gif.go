@@ -0,0 +1,74 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"io"
+	"strings"
+	"time"
+)
+
+// PlayGIF decodes a multi-frame GIF and animates it in place on out,
+// using ANSI cursor movement to redraw each frame at the GIF's declared
+// delay. It loops according to the GIF's declared loop count (0 means
+// forever) and only returns once that's exhausted, an error occurs, or
+// the caller stops reading (e.g. by closing out).
+func PlayGIF(imgData []byte, opts Options, out io.Writer) error {
+	g, err := gif.DecodeAll(bytes.NewReader(imgData))
+	if err != nil {
+		return fmt.Errorf("failed to decode GIF: %v", err)
+	}
+	if len(g.Image) == 0 {
+		return fmt.Errorf("GIF has no frames")
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, g.Config.Width, g.Config.Height))
+
+	frames := make([]string, len(g.Image))
+	delays := make([]time.Duration, len(g.Image))
+	linesPerFrame := 0
+
+	for i, frame := range g.Image {
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+
+		rendered, err := renderImage(canvas, opts)
+		if err != nil {
+			return err
+		}
+		frames[i] = rendered
+		if linesPerFrame == 0 {
+			linesPerFrame = strings.Count(rendered, "\n")
+		}
+
+		delay := time.Duration(g.Delay[i]) * 10 * time.Millisecond
+		if delay <= 0 {
+			delay = 100 * time.Millisecond
+		}
+		delays[i] = delay
+	}
+
+	// Per image/gif's contract: 0 means loop forever, a negative count
+	// (no Netscape loop extension) means play through once, and a
+	// positive count N means N additional passes after the first.
+	infinite := g.LoopCount == 0
+	passes := g.LoopCount
+	if passes < 0 {
+		passes = 0
+	}
+	for iteration := 0; infinite || iteration <= passes; iteration++ {
+		for i, frame := range frames {
+			if _, err := fmt.Fprint(out, frame); err != nil {
+				return err
+			}
+			time.Sleep(delays[i])
+			fmt.Fprintf(out, "\x1b[%dA", linesPerFrame)
+		}
+	}
+
+	// Leave the cursor below the last frame instead of on top of it.
+	fmt.Fprintf(out, "\x1b[%dB", linesPerFrame)
+	return nil
+}
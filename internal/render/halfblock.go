@@ -0,0 +1,39 @@
+package render
+
+import (
+	"image"
+	"strings"
+
+	"github.com/aybabtme/rgbterm"
+)
+
+// halfBlockGlyph is "▀" (U+2580 UPPER HALF BLOCK): its foreground paints
+// the top pixel of a cell, its background the bottom, giving 2x the
+// vertical resolution of a single colored character.
+const halfBlockGlyph = "▀"
+
+func renderHalfBlock(img image.Image) string {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	var result strings.Builder
+	for y := 0; y < h; y += 2 {
+		for x := 0; x < w; x++ {
+			tr, tg, tb, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+
+			var br, bg, bb uint32
+			if y+1 < h {
+				br, bg, bb, _ = img.At(bounds.Min.X+x, bounds.Min.Y+y+1).RGBA()
+			} else {
+				br, bg, bb = tr, tg, tb
+			}
+
+			result.WriteString(rgbterm.String(halfBlockGlyph,
+				uint8(tr>>8), uint8(tg>>8), uint8(tb>>8),
+				uint8(br>>8), uint8(bg>>8), uint8(bb>>8)))
+		}
+		result.WriteString("\n")
+	}
+
+	return result.String()
+}
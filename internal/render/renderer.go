@@ -0,0 +1,72 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF decoding with image.Decode
+	_ "image/jpeg" // register JPEG decoding with image.Decode
+	_ "image/png"  // register PNG decoding with image.Decode
+
+	"github.com/nfnt/resize"
+)
+
+// Mode selects how a decoded image is turned into terminal output.
+type Mode string
+
+const (
+	// ModeASCII maps pixel luminance onto a fixed character ramp.
+	ModeASCII Mode = "ascii"
+	// ModeBraille packs 2x4 pixels into a single Unicode braille glyph
+	// (U+2800-U+28FF) for roughly 8x the spatial resolution of ASCII.
+	ModeBraille Mode = "braille"
+	// ModeHalfBlock pairs each terminal cell with two vertically stacked
+	// pixels, using "▀" with independent foreground/background colors.
+	ModeHalfBlock Mode = "halfblock"
+)
+
+// Options configures a single Render call.
+type Options struct {
+	Mode Mode
+	// Width is the output width in columns. Zero means "use the
+	// decoded image's own width" (callers wanting terminal-aware sizing
+	// should resolve a width via DetectWidth first).
+	Width int
+	// Dither applies Floyd-Steinberg error diffusion before quantizing
+	// luminance, trading sharp edges for smoother gradients. Ignored by
+	// ModeHalfBlock, which already has full color resolution.
+	Dither bool
+}
+
+// Render decodes imgData and renders it as a string of ANSI-colored
+// terminal output according to opts.
+func Render(imgData []byte, opts Options) (string, error) {
+	img, _, err := image.Decode(bytes.NewReader(imgData))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode image: %v", err)
+	}
+	return renderImage(img, opts)
+}
+
+func renderImage(img image.Image, opts Options) (string, error) {
+	width := opts.Width
+	if width <= 0 {
+		width = img.Bounds().Dx()
+	}
+
+	switch opts.Mode {
+	case "", ModeASCII:
+		resized := resize.Resize(uint(width), 0, img, resize.Lanczos3)
+		return renderASCII(resized, opts.Dither), nil
+	case ModeBraille:
+		// Braille packs 2 source pixels per column, so render at double
+		// the requested column count to preserve the intended width.
+		resized := resize.Resize(uint(width*2), 0, img, resize.Lanczos3)
+		return renderBraille(resized, opts.Dither), nil
+	case ModeHalfBlock:
+		resized := resize.Resize(uint(width), 0, img, resize.Lanczos3)
+		return renderHalfBlock(resized), nil
+	default:
+		return "", fmt.Errorf("unknown render mode %q", opts.Mode)
+	}
+}
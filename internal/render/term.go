@@ -0,0 +1,21 @@
+package render
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// defaultWidth is used when the terminal size can't be determined (e.g.
+// stdout is redirected to a file).
+const defaultWidth = 80
+
+// DetectWidth returns the current terminal's column width, falling back
+// to defaultWidth when stdout isn't a terminal.
+func DetectWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return defaultWidth
+	}
+	return width
+}